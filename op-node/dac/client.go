@@ -0,0 +1,321 @@
+// Package dac implements a highly-available client over one or more Data Availability
+// Committee endpoints. It replaces handing a comma-separated URL list straight to the
+// underlying da-server client with per-endpoint health tracking, quorum writes, and
+// hedged reads.
+package dac
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethstorage/da-server/pkg/da/client"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// blobSize is the fixed size of an L2 blob accepted by the da-server client.
+const blobSize = 128 * 1024
+
+// Config configures the HA client.
+type Config struct {
+	// URLs of the individual DAC endpoints.
+	URLs []string
+	// WriteQuorum is the number (K) of endpoint acks required for Put to succeed.
+	WriteQuorum int
+	// Replicas is the number (N) of endpoints Put writes to. Must be >= WriteQuorum and
+	// <= len(URLs).
+	Replicas int
+	// HedgeTimeout is how long Get waits on the healthiest endpoint before also trying the
+	// next-healthiest in parallel.
+	HedgeTimeout time.Duration
+}
+
+func (c Config) Check() error {
+	if len(c.URLs) == 0 {
+		return fmt.Errorf("at least one dac URL is required")
+	}
+	if c.WriteQuorum <= 0 || c.Replicas <= 0 {
+		return fmt.Errorf("dac write-quorum and replicas must be positive")
+	}
+	if c.WriteQuorum > c.Replicas {
+		return fmt.Errorf("dac write-quorum (%d) cannot exceed replicas (%d)", c.WriteQuorum, c.Replicas)
+	}
+	if c.Replicas > len(c.URLs) {
+		return fmt.Errorf("dac replicas (%d) cannot exceed the number of configured URLs (%d)", c.Replicas, len(c.URLs))
+	}
+	return nil
+}
+
+// endpointClient is the subset of the single-URL da-server client that an endpoint calls. It is
+// declared here, rather than using *client.Client directly, so that tests can fake a slow or
+// failing endpoint without making real network calls.
+type endpointClient interface {
+	SyncBlob(ctx context.Context, comm common.Hash, blob hexutil.Bytes) error
+	GetBlobs(ctx context.Context, blobHashes []common.Hash) ([]hexutil.Bytes, error)
+}
+
+// endpoint wraps a single-URL da-server client together with its rolling health stats. Each
+// endpoint dials exactly one URL so that Put/Get can target individual endpoints, rather than
+// the da-server client's own multi-URL fan-out which always writes to (and requires an ack
+// from) every configured URL.
+type endpoint struct {
+	url    string
+	client endpointClient
+
+	mu          sync.Mutex
+	errorRate   float64       // exponentially weighted moving error rate, in [0,1]
+	latencyEMA  time.Duration // exponentially weighted moving average of successful call latency
+	lastSuccess time.Time
+	backoff     time.Duration
+}
+
+const (
+	healthDecay       = 0.2
+	initialBackoff    = 500 * time.Millisecond
+	maxBackoff        = 30 * time.Second
+	backoffMultiplier = 2
+)
+
+func (e *endpoint) recordResult(d time.Duration, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err != nil {
+		e.errorRate = e.errorRate + healthDecay*(1-e.errorRate)
+		if e.backoff == 0 {
+			e.backoff = initialBackoff
+		} else if e.backoff < maxBackoff {
+			e.backoff = time.Duration(float64(e.backoff) * backoffMultiplier)
+			if e.backoff > maxBackoff {
+				e.backoff = maxBackoff
+			}
+		}
+		return
+	}
+	e.errorRate = e.errorRate * (1 - healthDecay)
+	e.latencyEMA = time.Duration((1-healthDecay)*float64(e.latencyEMA) + healthDecay*float64(d))
+	e.lastSuccess = time.Now()
+	e.backoff = 0
+}
+
+// score ranks endpoints for selection: lower is healthier. Endpoints that are still inside
+// their backoff window are pushed to the back, including an endpoint that has never had a
+// successful call yet (lastSuccess is the zero time, so time.Since(lastSuccess) would otherwise
+// saturate near Go's max Duration and never be "< backoff", letting a 100%-failing endpoint rank
+// ahead of healthy ones). errorRate and latencyEMA are normalized to comparable units (parts per
+// 1000, and milliseconds) so neither term dominates the other by scale alone.
+func (e *endpoint) score() float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.backoff > 0 && (e.lastSuccess.IsZero() || time.Since(e.lastSuccess) < e.backoff) {
+		return e.errorRate*1000 + float64(time.Hour/time.Millisecond)
+	}
+	return e.errorRate*1000 + float64(e.latencyEMA/time.Millisecond)
+}
+
+// jitteredBackoff returns the endpoint's current backoff duration with +/-20% jitter applied,
+// so a thundering herd of retries does not all land on the same endpoint at once.
+func (e *endpoint) jitteredBackoff() time.Duration {
+	e.mu.Lock()
+	b := e.backoff
+	e.mu.Unlock()
+	if b == 0 {
+		return 0
+	}
+	jitter := 0.8 + 0.4*rand.Float64()
+	return time.Duration(float64(b) * jitter)
+}
+
+// Metrics are the Prometheus collectors the Client reports per-endpoint stats through.
+type Metrics struct {
+	Requests *prometheus.CounterVec
+	Errors   *prometheus.CounterVec
+	Latency  *prometheus.HistogramVec
+}
+
+func NewMetrics(ns string) *Metrics {
+	return &Metrics{
+		Requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns, Subsystem: "dac", Name: "requests_total",
+			Help: "Number of requests made per DAC endpoint and method",
+		}, []string{"endpoint", "method"}),
+		Errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns, Subsystem: "dac", Name: "errors_total",
+			Help: "Number of failed requests per DAC endpoint and method",
+		}, []string{"endpoint", "method"}),
+		Latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns, Subsystem: "dac", Name: "request_duration_seconds",
+			Help: "DAC endpoint request latency",
+		}, []string{"endpoint", "method"}),
+	}
+}
+
+// Client is a HA client multiplexing Put/Get calls across a set of DAC endpoints.
+type Client struct {
+	log       log.Logger
+	metrics   *Metrics
+	cfg       Config
+	endpoints []*endpoint
+}
+
+// New dials each URL in cfg.URLs and returns a Client ready to serve Put/Get calls.
+func New(cfg Config, log log.Logger, m *Metrics) (*Client, error) {
+	if err := cfg.Check(); err != nil {
+		return nil, err
+	}
+	endpoints := make([]*endpoint, len(cfg.URLs))
+	for i, url := range cfg.URLs {
+		endpoints[i] = &endpoint{url: url, client: client.New([]string{url})}
+	}
+	return &Client{log: log, metrics: m, cfg: cfg, endpoints: endpoints}, nil
+}
+
+func (c *Client) ranked() []*endpoint {
+	ranked := append([]*endpoint(nil), c.endpoints...)
+	sort.Slice(ranked, func(i, j int) bool { return ranked[i].score() < ranked[j].score() })
+	return ranked
+}
+
+// Put computes the blob's versioned-hash commitment and writes it to cfg.Replicas endpoints,
+// requiring cfg.WriteQuorum acks before returning the commitment.
+func (c *Client) Put(ctx context.Context, data []byte) ([]byte, error) {
+	if len(data) != blobSize {
+		return nil, fmt.Errorf("dac blob must be exactly %d bytes, got %d", blobSize, len(data))
+	}
+	var blob kzg4844.Blob
+	copy(blob[:], data)
+	commitment, err := kzg4844.BlobToCommitment(&blob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute blob commitment: %w", err)
+	}
+	comm := eth.KZGToVersionedHash(commitment)
+
+	ranked := c.ranked()
+	if len(ranked) < c.cfg.Replicas {
+		return nil, fmt.Errorf("only %d of %d required dac replicas are configured", len(ranked), c.cfg.Replicas)
+	}
+
+	results := make(chan error, c.cfg.Replicas)
+	for _, ep := range ranked[:c.cfg.Replicas] {
+		ep := ep
+		go func() {
+			if b := ep.jitteredBackoff(); b > 0 {
+				select {
+				case <-time.After(b):
+				case <-ctx.Done():
+					results <- ctx.Err()
+					return
+				}
+			}
+			start := time.Now()
+			err := ep.client.SyncBlob(ctx, comm, hexutil.Bytes(data))
+			ep.recordResult(time.Since(start), err)
+			c.observe(ep, "put", time.Since(start), err)
+			results <- err
+		}()
+	}
+
+	var acks int
+	var lastErr error
+	for i := 0; i < c.cfg.Replicas; i++ {
+		select {
+		case err := <-results:
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			acks++
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if acks < c.cfg.WriteQuorum {
+		return nil, fmt.Errorf("only received %d/%d required dac acks: %w", acks, c.cfg.WriteQuorum, lastErr)
+	}
+	return comm.Bytes(), nil
+}
+
+// Get fetches the blob behind commitment, trying the healthiest endpoint first and hedging to
+// the next-healthiest after cfg.HedgeTimeout if it hasn't responded yet. The underlying client
+// already verifies the returned blob's KZG commitment against the requested hash.
+func (c *Client) Get(ctx context.Context, commitment []byte) ([]byte, error) {
+	comm := common.BytesToHash(commitment)
+	ranked := c.ranked()
+	if len(ranked) == 0 {
+		return nil, fmt.Errorf("no dac endpoints configured")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		data []byte
+		err  error
+	}
+	results := make(chan result, 2)
+	fetch := func(ep *endpoint) {
+		start := time.Now()
+		blobs, err := ep.client.GetBlobs(ctx, []common.Hash{comm})
+		ep.recordResult(time.Since(start), err)
+		c.observe(ep, "get", time.Since(start), err)
+		var data []byte
+		if err == nil {
+			data = blobs[0]
+		}
+		results <- result{data, err}
+	}
+
+	go fetch(ranked[0])
+	// launched tracks how many fetches have actually been started, since only a single hedge
+	// is ever issued: the loop below must be bounded by this, not by len(ranked), or it hangs
+	// forever once both the primary and hedge fail on a 3+ endpoint deployment.
+	launched := 1
+	hedged := false
+	timer := time.NewTimer(c.cfg.HedgeTimeout)
+	defer timer.Stop()
+
+	var lastErr error
+	attempts := 0
+	for attempts < launched {
+		select {
+		case r := <-results:
+			attempts++
+			if r.err == nil {
+				return r.data, nil
+			}
+			lastErr = r.err
+			if !hedged && len(ranked) > 1 {
+				hedged = true
+				launched++
+				go fetch(ranked[1])
+			}
+		case <-timer.C:
+			if !hedged && len(ranked) > 1 {
+				hedged = true
+				launched++
+				go fetch(ranked[1])
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return nil, fmt.Errorf("all dac endpoints failed: %w", lastErr)
+}
+
+func (c *Client) observe(ep *endpoint, method string, d time.Duration, err error) {
+	if c.metrics == nil {
+		return
+	}
+	c.metrics.Requests.WithLabelValues(ep.url, method).Inc()
+	c.metrics.Latency.WithLabelValues(ep.url, method).Observe(d.Seconds())
+	if err != nil {
+		c.metrics.Errors.WithLabelValues(ep.url, method).Inc()
+	}
+}
@@ -0,0 +1,290 @@
+package dac
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEndpointClient is a controllable stand-in for the real da-server single-endpoint client,
+// used to exercise Put/Get's quorum and hedging behavior without any real network calls.
+type fakeEndpointClient struct {
+	delay time.Duration
+	err   error
+	blob  hexutil.Bytes
+}
+
+func (f *fakeEndpointClient) SyncBlob(ctx context.Context, comm common.Hash, blob hexutil.Bytes) error {
+	if f.delay > 0 {
+		select {
+		case <-time.After(f.delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return f.err
+}
+
+func (f *fakeEndpointClient) GetBlobs(ctx context.Context, blobHashes []common.Hash) ([]hexutil.Bytes, error) {
+	if f.delay > 0 {
+		select {
+		case <-time.After(f.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []hexutil.Bytes{f.blob}, nil
+}
+
+func fakeEndpoint(url string, c endpointClient) *endpoint {
+	return &endpoint{url: url, client: c}
+}
+
+func TestConfigCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{
+			name:    "NoURLs",
+			cfg:     Config{WriteQuorum: 1, Replicas: 1},
+			wantErr: "at least one dac URL is required",
+		},
+		{
+			name:    "NonPositiveWriteQuorum",
+			cfg:     Config{URLs: []string{"http://a"}, WriteQuorum: 0, Replicas: 1},
+			wantErr: "dac write-quorum and replicas must be positive",
+		},
+		{
+			name:    "NonPositiveReplicas",
+			cfg:     Config{URLs: []string{"http://a"}, WriteQuorum: 1, Replicas: 0},
+			wantErr: "dac write-quorum and replicas must be positive",
+		},
+		{
+			name:    "WriteQuorumExceedsReplicas",
+			cfg:     Config{URLs: []string{"http://a", "http://b"}, WriteQuorum: 2, Replicas: 1},
+			wantErr: "dac write-quorum (2) cannot exceed replicas (1)",
+		},
+		{
+			name:    "ReplicasExceedURLs",
+			cfg:     Config{URLs: []string{"http://a"}, WriteQuorum: 1, Replicas: 2},
+			wantErr: "dac replicas (2) cannot exceed the number of configured URLs (1)",
+		},
+		{
+			name: "Valid",
+			cfg:  Config{URLs: []string{"http://a", "http://b"}, WriteQuorum: 1, Replicas: 2},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Check()
+			if test.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestEndpointRecordResult(t *testing.T) {
+	t.Run("SuccessClearsBackoffAndDecaysErrorRate", func(t *testing.T) {
+		ep := &endpoint{}
+		ep.recordResult(0, assertErr)
+		require.Greater(t, ep.backoff, time.Duration(0))
+		require.Greater(t, ep.errorRate, 0.0)
+
+		ep.recordResult(10*time.Millisecond, nil)
+		require.Equal(t, time.Duration(0), ep.backoff)
+		require.False(t, ep.lastSuccess.IsZero())
+		require.Less(t, ep.errorRate, 0.2)
+	})
+
+	t.Run("RepeatedErrorsGrowBackoffExponentiallyUpToMax", func(t *testing.T) {
+		ep := &endpoint{}
+		var last time.Duration
+		for i := 0; i < 20; i++ {
+			ep.recordResult(0, assertErr)
+			require.GreaterOrEqual(t, ep.backoff, last)
+			last = ep.backoff
+		}
+		require.Equal(t, maxBackoff, ep.backoff)
+	})
+}
+
+// cooldownScore is the score floor any endpoint "in cooldown" (backoff active, and either a
+// recent success or none at all) must clear, used by the tests below instead of a raw
+// time.Hour comparison, since score() works in milliseconds, not nanoseconds.
+const cooldownScore = float64(time.Hour / time.Millisecond)
+
+func TestEndpointScore(t *testing.T) {
+	t.Run("UntouchedEndpointScoresZero", func(t *testing.T) {
+		ep := &endpoint{}
+		require.Equal(t, 0.0, ep.score())
+	})
+
+	t.Run("InBackoffWindowScoresWorstRegardlessOfErrorRate", func(t *testing.T) {
+		ep := &endpoint{}
+		ep.recordResult(time.Millisecond, nil) // sets a recent lastSuccess
+		ep.recordResult(0, assertErr)          // failure right after: still within the backoff window
+		require.Greater(t, ep.score(), cooldownScore)
+	})
+
+	t.Run("NeverSucceededButFailingScoresWorst", func(t *testing.T) {
+		// lastSuccess is the zero time here, which must not be mistaken for "outside the
+		// backoff window" - an endpoint that has never once succeeded must still rank behind
+		// a healthy endpoint, not ahead of it.
+		ep := &endpoint{}
+		ep.recordResult(0, assertErr)
+		require.Greater(t, ep.score(), cooldownScore)
+
+		healthy := &endpoint{}
+		healthy.recordResult(20*time.Millisecond, nil)
+		require.Greater(t, ep.score(), healthy.score())
+	})
+}
+
+func TestEndpointJitteredBackoff(t *testing.T) {
+	t.Run("ZeroBackoffStaysZero", func(t *testing.T) {
+		ep := &endpoint{}
+		require.Equal(t, time.Duration(0), ep.jitteredBackoff())
+	})
+
+	t.Run("JitterStaysWithinPlusMinus20Percent", func(t *testing.T) {
+		ep := &endpoint{backoff: time.Second}
+		for i := 0; i < 50; i++ {
+			b := ep.jitteredBackoff()
+			require.GreaterOrEqual(t, b, 800*time.Millisecond)
+			require.LessOrEqual(t, b, 1200*time.Millisecond)
+		}
+	})
+}
+
+func TestClientRanked(t *testing.T) {
+	healthy := &endpoint{url: "http://healthy"}
+
+	inBackoff := &endpoint{url: "http://in-backoff"}
+	inBackoff.recordResult(time.Millisecond, nil)
+	inBackoff.recordResult(0, assertErr)
+
+	c := &Client{endpoints: []*endpoint{inBackoff, healthy}}
+	ranked := c.ranked()
+	require.Len(t, ranked, 2)
+	require.Equal(t, "http://healthy", ranked[0].url)
+	require.Equal(t, "http://in-backoff", ranked[1].url)
+
+	// ranked must not mutate the original slice order.
+	require.Equal(t, "http://in-backoff", c.endpoints[0].url)
+}
+
+// assertErr is a stand-in error used only to exercise the failure path of recordResult.
+var assertErr = errPlaceholder{}
+
+type errPlaceholder struct{}
+
+func (errPlaceholder) Error() string { return "placeholder error" }
+
+func TestClientPut(t *testing.T) {
+	data := make([]byte, blobSize)
+
+	t.Run("QuorumMet", func(t *testing.T) {
+		c := &Client{
+			log: log.New(),
+			cfg: Config{Replicas: 2, WriteQuorum: 2},
+			endpoints: []*endpoint{
+				fakeEndpoint("http://a", &fakeEndpointClient{}),
+				fakeEndpoint("http://b", &fakeEndpointClient{}),
+			},
+		}
+		comm, err := c.Put(context.Background(), data)
+		require.NoError(t, err)
+		require.Len(t, comm, 32)
+	})
+
+	t.Run("QuorumNotMetWhenAReplicaFails", func(t *testing.T) {
+		c := &Client{
+			log: log.New(),
+			cfg: Config{Replicas: 2, WriteQuorum: 2},
+			endpoints: []*endpoint{
+				fakeEndpoint("http://a", &fakeEndpointClient{}),
+				fakeEndpoint("http://b", &fakeEndpointClient{err: assertErr}),
+			},
+		}
+		_, err := c.Put(context.Background(), data)
+		require.ErrorContains(t, err, "only received 1/2 required dac acks")
+		require.ErrorIs(t, err, assertErr)
+	})
+
+	t.Run("ContextCancelledDuringBackoffReturnsPromptly", func(t *testing.T) {
+		ep := fakeEndpoint("http://a", &fakeEndpointClient{delay: time.Second})
+		ep.backoff = time.Minute // forces Put to wait out jitteredBackoff before even dialing
+		c := &Client{
+			log:       log.New(),
+			cfg:       Config{Replicas: 1, WriteQuorum: 1},
+			endpoints: []*endpoint{ep},
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := c.Put(ctx, data)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+}
+
+func TestClientGet(t *testing.T) {
+	t.Run("PrimarySucceedsWithoutHedging", func(t *testing.T) {
+		blob := hexutil.Bytes(make([]byte, blobSize))
+		c := &Client{
+			log: log.New(),
+			cfg: Config{HedgeTimeout: time.Hour},
+			endpoints: []*endpoint{
+				fakeEndpoint("http://a", &fakeEndpointClient{blob: blob}),
+				fakeEndpoint("http://b", &fakeEndpointClient{err: assertErr}),
+			},
+		}
+		data, err := c.Get(context.Background(), make([]byte, 32))
+		require.NoError(t, err)
+		require.Equal(t, []byte(blob), data)
+	})
+
+	t.Run("HedgesToSecondEndpointWhenPrimaryIsSlow", func(t *testing.T) {
+		blob := hexutil.Bytes(make([]byte, blobSize))
+		c := &Client{
+			log: log.New(),
+			cfg: Config{HedgeTimeout: 10 * time.Millisecond},
+			endpoints: []*endpoint{
+				fakeEndpoint("http://slow", &fakeEndpointClient{delay: time.Second}),
+				fakeEndpoint("http://fast", &fakeEndpointClient{blob: blob}),
+			},
+		}
+		start := time.Now()
+		data, err := c.Get(context.Background(), make([]byte, 32))
+		require.NoError(t, err)
+		require.Equal(t, []byte(blob), data)
+		require.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+
+	t.Run("ErrorsWhenAllEndpointsFail", func(t *testing.T) {
+		c := &Client{
+			log: log.New(),
+			cfg: Config{HedgeTimeout: time.Hour},
+			endpoints: []*endpoint{
+				fakeEndpoint("http://a", &fakeEndpointClient{err: assertErr}),
+			},
+		}
+		_, err := c.Get(context.Background(), make([]byte, 32))
+		require.ErrorContains(t, err, "all dac endpoints failed")
+		require.ErrorIs(t, err, assertErr)
+	})
+}
@@ -0,0 +1,109 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// EnvVarPrefix is shared with the upstream op-node flags; this fork's flags live alongside them
+// under the same prefix.
+const EnvVarPrefix = "OP_NODE"
+
+const (
+	SequencerCategory = "3. SEQUENCER"
+	AltDACategory     = "6. ALT-DA (EXPERIMENTAL)"
+	MiscCategory      = "7. MISC"
+)
+
+func prefixEnvVars(names ...string) []string {
+	envs := make([]string, 0, len(names))
+	for _, name := range names {
+		envs = append(envs, EnvVarPrefix+"_"+name)
+	}
+	return envs
+}
+
+var (
+	SequencerStoppedFlag = &cli.BoolFlag{
+		Name:     "sequencer.stopped",
+		Usage:    "Initialize the sequencer in a stopped state. The sequencer can be started using the admin_startSequencer RPC",
+		EnvVars:  prefixEnvVars("SEQUENCER_STOPPED"),
+		Category: SequencerCategory,
+	}
+
+	// SequencerPriorityFlag controls Driver.Config.SequencerPriority: when set, the driver's main
+	// loop checks for a due sequencer build/seal step before draining derivation, gossip, or L1
+	// head/finalization events.
+	SequencerPriorityFlag = &cli.BoolFlag{
+		Name:     "sequencer.priority",
+		Usage:    "Give a due sequencer block-build/seal step precedence over derivation, gossip, and L1 head/finalization work in the driver's main loop. Only meaningful when sequencer.enabled is set.",
+		EnvVars:  prefixEnvVars("SEQUENCER_PRIORITY"),
+		Category: SequencerCategory,
+	}
+
+	// SequencerCombinedEngineFlag controls Driver.Config.SequencerCombinedEngine: when set, the
+	// sequencer seals blocks with a single combined engine_getPayloadAndForkchoiceUpdatedVx call
+	// if the connected L2 execution engine supports it, falling back to the split calls otherwise.
+	SequencerCombinedEngineFlag = &cli.BoolFlag{
+		Name:     "sequencer.combined-engine",
+		Usage:    "Seal blocks with a single combined engine_getPayloadAndForkchoiceUpdatedVx call when the L2 execution engine supports it, instead of separate GetPayload and ForkchoiceUpdated calls.",
+		EnvVars:  prefixEnvVars("SEQUENCER_COMBINED_ENGINE"),
+		Category: SequencerCategory,
+	}
+
+	// SequencerPersistConfigFlag enables ActiveConfigPersistence, so that the sequencer
+	// active/inactive state chosen via the admin_startSequencer/admin_stopSequencer RPCs survives
+	// a restart.
+	SequencerPersistConfigFlag = &cli.BoolFlag{
+		Name:     "sequencer.persist-config",
+		Usage:    "Persist the sequencer active/inactive state set via the admin_startSequencer/admin_stopSequencer RPCs to disk, so it is restored across restarts.",
+		EnvVars:  prefixEnvVars("SEQUENCER_PERSIST_CONFIG"),
+		Category: SequencerCategory,
+	}
+
+	// NodeReadOnlyFlag puts the node into ReadOnly/observer mode: see Config.ReadOnly.
+	NodeReadOnlyFlag = &cli.BoolFlag{
+		Name:     "node.readonly",
+		Usage:    "Run as a read-only/observer node: force-disables the sequencer, the admin write RPCs, and gossip publishing, and skips loading a P2P signer.",
+		EnvVars:  prefixEnvVars("NODE_READONLY"),
+		Category: MiscCategory,
+	}
+
+	// L2EngineSyncFlag enables sync.EngineSync: unsafe payloads received over gossip are forwarded
+	// straight to the L2 execution engine so it can snap-sync state on its own.
+	L2EngineSyncFlag = &cli.BoolFlag{
+		Name:     "l2.engine-sync",
+		Usage:    "Forward unsafe L2 payloads received over gossip directly to the execution engine via newPayload/forkchoiceUpdated, so it can snap-sync state, bypassing derivation until the engine reports the synced head as VALID. Verifier nodes only.",
+		EnvVars:  prefixEnvVars("L2_ENGINE_SYNC"),
+		Category: SequencerCategory,
+	}
+
+	// L2SkipSyncStartCheckFlag skips the L1-origin consistency check of unsafe L2 blocks when
+	// determining the sync-starting point; recommended alongside L2EngineSyncFlag.
+	L2SkipSyncStartCheckFlag = &cli.BoolFlag{
+		Name:     "l2.skip-sync-start-check",
+		Usage:    "Skip the sanity check of consistency of L1 origins of the unsafe L2 blocks when determining the sync-starting point. Recommended when utilizing l2.engine-sync.",
+		EnvVars:  prefixEnvVars("L2_SKIP_SYNC_START_CHECK"),
+		Category: SequencerCategory,
+	}
+
+	DACUrlsFlag = &cli.StringFlag{
+		Name:     "dac.urls",
+		Usage:    "Comma-separated list of Data Availability Committee endpoint URLs. Required for a sequencer once the L2 blob time is set.",
+		EnvVars:  prefixEnvVars("DAC_URLS"),
+		Category: AltDACategory,
+	}
+
+	DACReplicasFlag = &cli.IntFlag{
+		Name:     "dac.replicas",
+		Usage:    "Number of DAC endpoints each Put writes to. Defaults to all configured URLs.",
+		EnvVars:  prefixEnvVars("DAC_REPLICAS"),
+		Category: AltDACategory,
+	}
+
+	DACWriteQuorumFlag = &cli.IntFlag{
+		Name:     "dac.write-quorum",
+		Usage:    "Number of DAC endpoint acks required for a Put to succeed. Defaults to dac.replicas.",
+		EnvVars:  prefixEnvVars("DAC_WRITE_QUORUM"),
+		Category: AltDACategory,
+	}
+)
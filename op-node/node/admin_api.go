@@ -0,0 +1,70 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ErrReadOnly is returned by the admin write RPCs (StartSequencer, StopSequencer) when the node
+// is running in Config.ReadOnly mode.
+var ErrReadOnly = errors.New("node is running in read-only mode, admin write RPCs are disabled")
+
+// SequencerDriver is the subset of the driver that the admin RPC needs in order to start/stop
+// sequencing and report whether the sequencer is currently active.
+type SequencerDriver interface {
+	StartSequencer(ctx context.Context, blockHash common.Hash) error
+	StopSequencer(ctx context.Context) (common.Hash, error)
+	SequencerActive(ctx context.Context) (bool, error)
+}
+
+// AdminAPI exposes the admin_* RPC methods used to start/stop the sequencer and query whether it
+// is currently active. StartSequencer/StopSequencer record the new state via ConfigPersistence
+// once the driver confirms the transition, so that the operator-chosen state survives a restart.
+type AdminAPI struct {
+	log         log.Logger
+	dr          SequencerDriver
+	persistence ConfigPersistence
+	readOnly    bool
+}
+
+func NewAdminAPI(dr SequencerDriver, persistence ConfigPersistence, readOnly bool, log log.Logger) *AdminAPI {
+	if persistence == nil {
+		persistence = &DisabledConfigPersistence{}
+	}
+	return &AdminAPI{log: log, dr: dr, persistence: persistence, readOnly: readOnly}
+}
+
+func (a *AdminAPI) StartSequencer(ctx context.Context, blockHash common.Hash) error {
+	if a.readOnly {
+		return ErrReadOnly
+	}
+	if err := a.dr.StartSequencer(ctx, blockHash); err != nil {
+		return fmt.Errorf("failed to start sequencer: %w", err)
+	}
+	if err := a.persistence.SequencerStarted(); err != nil {
+		a.log.Error("failed to persist sequencer started state", "err", err)
+	}
+	return nil
+}
+
+func (a *AdminAPI) StopSequencer(ctx context.Context) (common.Hash, error) {
+	if a.readOnly {
+		return common.Hash{}, ErrReadOnly
+	}
+	hash, err := a.dr.StopSequencer(ctx)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to stop sequencer: %w", err)
+	}
+	if err := a.persistence.SequencerStopped(); err != nil {
+		a.log.Error("failed to persist sequencer stopped state", "err", err)
+	}
+	return hash, nil
+}
+
+func (a *AdminAPI) SequencerActive(ctx context.Context) (bool, error) {
+	return a.dr.SequencerActive(ctx)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+	"github.com/ethereum-optimism/optimism/op-node/dac"
 	"github.com/ethereum-optimism/optimism/op-node/flags"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
@@ -17,7 +18,6 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	"github.com/ethereum/go-ethereum/log"
-	"github.com/ethstorage/da-server/pkg/da/client"
 	"github.com/urfave/cli/v2"
 )
 
@@ -29,10 +29,20 @@ type Config struct {
 
 	Supervisor SupervisorEndpointSetup
 
+	// Driver controls the sequencing and derivation loop, including whether the combined
+	// engine_getPayloadAndForkchoiceUpdatedVx path is attempted at block seal time; the engine
+	// client probes for the capability and transparently falls back to the split calls.
 	Driver driver.Config
 
 	Rollup rollup.Config
 
+	// ReadOnly runs the node as a public/observer node: the sequencer is force-disabled, the
+	// admin write RPCs (StartSequencer, StopSequencer, p2p peer mutation) are disabled, gossip
+	// publishing is rejected, and the P2P signer is never loaded. This gives operators a
+	// first-class way to run nodes that cannot accidentally mutate chain state or gossip
+	// unsigned payloads, similar to l2geth's rollup readonly mode.
+	ReadOnly bool
+
 	// P2PSigner will be used for signing off on published content
 	// if the node is sequencing and if the p2p stack is enabled
 	P2PSigner p2p.SignerSetup
@@ -48,6 +58,10 @@ type Config struct {
 	// Used to poll the L1 for new finalized or safe blocks
 	L1EpochPollInterval time.Duration
 
+	// ConfigPersistence stores the operator-chosen sequencer active/inactive state across
+	// restarts. It is a DisabledConfigPersistence unless `--sequencer.persist-config` is set, in
+	// which case it is backed by an ActiveConfigPersistence file updated by the admin
+	// StartSequencer/StopSequencer RPCs.
 	ConfigPersistence ConfigPersistence
 
 	// Path to store safe head database. Disabled when set to empty string
@@ -62,6 +76,9 @@ type Config struct {
 	// Optional
 	Tracer Tracer
 
+	// Sync configures how the driver brings the node's L2 chain up to date, including the
+	// EngineSync mode where unsafe payloads are forwarded straight to the EL for snap-sync and
+	// derivation only resumes once the EL reports VALID for the synced head.
 	Sync sync.Config
 
 	// To halt when detecting the node does not support a signaled protocol version
@@ -88,20 +105,52 @@ func ReadDACConfigFromCLI(c *cli.Context) *DACConfig {
 	if urls == "" {
 		return nil
 	}
+	urlList := strings.Split(urls, ",")
+	replicas := c.Int(flags.DACReplicasFlag.Name)
+	if replicas == 0 {
+		replicas = len(urlList)
+	}
+	quorum := c.Int(flags.DACWriteQuorumFlag.Name)
+	if quorum == 0 {
+		quorum = replicas
+	}
 	return &DACConfig{
-		URLS: strings.Split(urls, ","),
+		URLS:        urlList,
+		WriteQuorum: quorum,
+		Replicas:    replicas,
 	}
 }
 
 type DACConfig struct {
 	URLS []string
+	// WriteQuorum (K) is the number of endpoint acks Put requires to succeed.
+	WriteQuorum int
+	// Replicas (N) is the number of endpoints Put writes to.
+	Replicas int
 }
 
-func (dacConfig *DACConfig) Client() engine.DACClient {
+func (dacConfig *DACConfig) Check() error {
+	if dacConfig == nil {
+		return nil
+	}
+	return dac.Config{URLs: dacConfig.URLS, WriteQuorum: dacConfig.WriteQuorum, Replicas: dacConfig.Replicas, HedgeTimeout: time.Second}.Check()
+}
+
+func (dacConfig *DACConfig) Client(log log.Logger, m *dac.Metrics) engine.DACClient {
 	if dacConfig == nil || len(dacConfig.URLS) == 0 {
 		return nil
 	}
-	return client.New(dacConfig.URLS)
+	c, err := dac.New(dac.Config{
+		URLs:         dacConfig.URLS,
+		WriteQuorum:  dacConfig.WriteQuorum,
+		Replicas:     dacConfig.Replicas,
+		HedgeTimeout: time.Second,
+	}, log, m)
+	if err != nil {
+		log.Error("failed to construct dac client", "err", err)
+		return nil
+	}
+	return c
 }
 
 // ConductorRPCFunc retrieves the endpoint. The RPC may not immediately be available.
@@ -136,6 +185,10 @@ func (m MetricsConfig) Check() error {
 }
 
 func (cfg *Config) LoadPersisted(log log.Logger) error {
+	if cfg.ReadOnly && cfg.Driver.SequencerEnabled {
+		log.Warn("read-only mode is enabled, forcing the sequencer off")
+		cfg.Driver.SequencerEnabled = false
+	}
 	if !cfg.Driver.SequencerEnabled {
 		return nil
 	}
@@ -194,6 +247,27 @@ func (cfg *Config) Check() error {
 	if !(cfg.RollupHalt == "" || cfg.RollupHalt == "major" || cfg.RollupHalt == "minor" || cfg.RollupHalt == "patch") {
 		return fmt.Errorf("invalid rollup halting option: %q", cfg.RollupHalt)
 	}
+	if cfg.Driver.SequencerPriority && !cfg.Driver.SequencerEnabled {
+		return fmt.Errorf("sequencer priority scheduling can only be enabled when the sequencer is enabled")
+	}
+	if cfg.Sync.SyncMode == sync.EngineSync && cfg.Driver.SequencerEnabled {
+		return fmt.Errorf("engine-sync can only be enabled on verifier nodes, not sequencers")
+	}
+	// The further requirement that the configured L2 endpoint advertise engine API v2+ is checked
+	// by engine.CheckEngineSyncSupport against the live connection once the engine client dials
+	// L2, since L2EndpointSetup only describes how to reach the endpoint and not its capabilities
+	// ahead of time.
+	if cfg.ReadOnly {
+		if cfg.ConductorEnabled {
+			return fmt.Errorf("read-only mode cannot be combined with the conductor")
+		}
+		if cfg.Driver.SequencerEnabled {
+			return fmt.Errorf("read-only mode cannot be combined with the sequencer")
+		}
+		if cfg.P2PSigner != nil {
+			return fmt.Errorf("read-only mode cannot be combined with a configured P2P signer")
+		}
+	}
 	if cfg.ConductorEnabled {
 		if state, _ := cfg.ConfigPersistence.SequencerState(); state != StateUnset {
 			return fmt.Errorf("config persistence must be disabled when conductor is enabled")
@@ -214,9 +288,24 @@ func (cfg *Config) Check() error {
 	if (!cfg.Driver.SequencerEnabled || !cfg.Rollup.IsL2BlobTimeSet()) && cfg.DACConfig != nil {
 		return fmt.Errorf("dac.urls can only be set for sequencer when l2 blob time is set")
 	}
+	if err := cfg.DACConfig.Check(); err != nil {
+		return fmt.Errorf("dac config error: %w", err)
+	}
 	return nil
 }
 
 func (cfg *Config) P2PEnabled() bool {
 	return cfg.P2P != nil && !cfg.P2P.Disabled()
 }
+
+// P2PSignerEnabled reports whether the P2P signer should be loaded. ReadOnly nodes never load a
+// signer, since they never publish signed gossip.
+func (cfg *Config) P2PSignerEnabled() bool {
+	return !cfg.ReadOnly && cfg.P2PSigner != nil
+}
+
+// GossipPublishEnabled reports whether this node is allowed to publish gossip (unsafe blocks,
+// etc). ReadOnly nodes only consume gossip, they never publish it.
+func (cfg *Config) GossipPublishEnabled() bool {
+	return !cfg.ReadOnly
+}
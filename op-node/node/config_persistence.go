@@ -0,0 +1,124 @@
+package node
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum-optimism/optimism/op-node/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// RuntimeState records the last operator-requested sequencer state so it can be restored
+// across restarts.
+type RuntimeState string
+
+const (
+	StateStarted RuntimeState = "started"
+	StateStopped RuntimeState = "stopped"
+	StateUnset   RuntimeState = ""
+)
+
+// ConfigPersistence is used to store the configuration so that the sequencer can resume its
+// previous state after a restart.
+type ConfigPersistence interface {
+	SequencerState() (RuntimeState, error)
+	SequencerStarted() error
+	SequencerStopped() error
+}
+
+// DisabledConfigPersistence is used when config persistence is not enabled. It always reports
+// an unset state and ignores writes, preserving the pre-existing `--sequencer.stopped` behavior.
+type DisabledConfigPersistence struct{}
+
+func (n *DisabledConfigPersistence) SequencerStarted() error { return nil }
+
+func (n *DisabledConfigPersistence) SequencerStopped() error { return nil }
+
+func (n *DisabledConfigPersistence) SequencerState() (RuntimeState, error) {
+	return StateUnset, nil
+}
+
+// activeSequencerConfig is the on-disk representation written by ActiveConfigPersistence.
+type activeSequencerConfig struct {
+	Sequencer RuntimeState `json:"sequencerState"`
+}
+
+// ActiveConfigPersistence persists the sequencer active/inactive state to a file on disk so that
+// `StartSequencer`/`StopSequencer` RPC calls survive a node restart. Writes are performed as a
+// temp-file-plus-rename plus an fsync of the parent directory so a crash mid-write can never
+// leave a torn or missing config behind.
+type ActiveConfigPersistence struct {
+	path string
+}
+
+// NewConfigPersistence creates a ActiveConfigPersistence that reads & writes the sequencer state
+// to the given path.
+func NewConfigPersistence(path string) *ActiveConfigPersistence {
+	return &ActiveConfigPersistence{path: path}
+}
+
+func (p *ActiveConfigPersistence) SequencerStarted() error {
+	return p.writeConfig(StateStarted)
+}
+
+func (p *ActiveConfigPersistence) SequencerStopped() error {
+	return p.writeConfig(StateStopped)
+}
+
+func (p *ActiveConfigPersistence) writeConfig(state RuntimeState) error {
+	out, err := json.Marshal(&activeSequencerConfig{Sequencer: state})
+	if err != nil {
+		return fmt.Errorf("failed to marshal sequencer config: %w", err)
+	}
+	dir := filepath.Dir(p.path)
+	tmp, err := os.CreateTemp(dir, ".sequencer-state-*.json.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temp sequencer config file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(out); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write temp sequencer config file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to fsync temp sequencer config file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temp sequencer config file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), p.path); err != nil {
+		return fmt.Errorf("failed to rename sequencer config file into place: %w", err)
+	}
+	if dirF, err := os.Open(dir); err == nil {
+		defer dirF.Close()
+		_ = dirF.Sync()
+	}
+	return nil
+}
+
+// ReadConfigPersistenceFromCLI returns an ActiveConfigPersistence backed by path when
+// --sequencer.persist-config is set, and a DisabledConfigPersistence otherwise.
+func ReadConfigPersistenceFromCLI(c *cli.Context, path string) ConfigPersistence {
+	if !c.Bool(flags.SequencerPersistConfigFlag.Name) {
+		return &DisabledConfigPersistence{}
+	}
+	return NewConfigPersistence(path)
+}
+
+func (p *ActiveConfigPersistence) SequencerState() (RuntimeState, error) {
+	data, err := os.ReadFile(p.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return StateUnset, nil
+	} else if err != nil {
+		return StateUnset, fmt.Errorf("failed to read sequencer config file: %w", err)
+	}
+	var cfg activeSequencerConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return StateUnset, fmt.Errorf("failed to unmarshal sequencer config file: %w", err)
+	}
+	return cfg.Sequencer, nil
+}
@@ -0,0 +1,77 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveConfigPersistence(t *testing.T) {
+	create := func(t *testing.T) *ActiveConfigPersistence {
+		dir := t.TempDir()
+		return NewConfigPersistence(dir + "/state")
+	}
+
+	t.Run("SequencerStateUnsetWhenFileDoesNotExist", func(t *testing.T) {
+		config := create(t)
+		state, err := config.SequencerState()
+		require.NoError(t, err)
+		require.Equal(t, StateUnset, state)
+	})
+
+	t.Run("PersistSequencerStarted", func(t *testing.T) {
+		config1 := create(t)
+		require.NoError(t, config1.SequencerStarted())
+		state, err := config1.SequencerState()
+		require.NoError(t, err)
+		require.Equal(t, StateStarted, state)
+
+		config2 := NewConfigPersistence(config1.path)
+		state, err = config2.SequencerState()
+		require.NoError(t, err)
+		require.Equal(t, StateStarted, state)
+	})
+
+	t.Run("PersistSequencerStopped", func(t *testing.T) {
+		config1 := create(t)
+		require.NoError(t, config1.SequencerStopped())
+		state, err := config1.SequencerState()
+		require.NoError(t, err)
+		require.Equal(t, StateStopped, state)
+
+		config2 := NewConfigPersistence(config1.path)
+		state, err = config2.SequencerState()
+		require.NoError(t, err)
+		require.Equal(t, StateStopped, state)
+	})
+
+	t.Run("PersistMultipleChanges", func(t *testing.T) {
+		config := create(t)
+		require.NoError(t, config.SequencerStarted())
+		state, err := config.SequencerState()
+		require.NoError(t, err)
+		require.Equal(t, StateStarted, state)
+
+		require.NoError(t, config.SequencerStopped())
+		state, err = config.SequencerState()
+		require.NoError(t, err)
+		require.Equal(t, StateStopped, state)
+	})
+}
+
+func TestDisabledConfigPersistence_AlwaysUnset(t *testing.T) {
+	config := DisabledConfigPersistence{}
+	state, err := config.SequencerState()
+	require.NoError(t, err)
+	require.Equal(t, StateUnset, state)
+
+	require.NoError(t, config.SequencerStarted())
+	state, err = config.SequencerState()
+	require.NoError(t, err)
+	require.Equal(t, StateUnset, state)
+
+	require.NoError(t, config.SequencerStopped())
+	state, err = config.SequencerState()
+	require.NoError(t, err)
+	require.Equal(t, StateUnset, state)
+}
@@ -0,0 +1,207 @@
+package node
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEndpointSetup is a no-op L1EndpointSetup stand-in: Config.Check() only
+// ever calls Check(), never Setup(), so Setup is unreachable here.
+type fakeEndpointSetup struct {
+	checkErr error
+}
+
+func (f fakeEndpointSetup) Setup(ctx context.Context, log log.Logger, rollupCfg *rollup.Config) (client.RPC, *sources.L1ClientConfig, error) {
+	panic("not used by Config.Check()")
+}
+
+func (f fakeEndpointSetup) Check() error {
+	return f.checkErr
+}
+
+type fakeL2EndpointSetup struct{}
+
+func (fakeL2EndpointSetup) Setup(ctx context.Context, log log.Logger, rollupCfg *rollup.Config) (client.RPC, *sources.EngineClientConfig, error) {
+	panic("not used by Config.Check()")
+}
+
+func (fakeL2EndpointSetup) Check() error { return nil }
+
+// fakeSignerSetup is a no-op p2p.SignerSetup stand-in, used only to make cfg.P2PSigner non-nil.
+type fakeSignerSetup struct{}
+
+func (fakeSignerSetup) SetupSigner(ctx context.Context) (p2p.Signer, error) {
+	panic("not used by Config.Check()")
+}
+
+// validRollupConfig returns a minimal rollup.Config that passes rollup.Config.Check() on its own,
+// with no Ecotone/Interop upgrade scheduled so Config.Check() doesn't require a Beacon/Supervisor
+// endpoint to be configured.
+func validRollupConfig() rollup.Config {
+	return rollup.Config{
+		Genesis: rollup.Genesis{
+			L1:     eth.BlockID{Hash: common.HexToHash("0x1")},
+			L2:     eth.BlockID{Hash: common.HexToHash("0x2")},
+			L2Time: 1,
+			SystemConfig: eth.SystemConfig{
+				BatcherAddr: common.HexToAddress("0x1"),
+				Scalar:      eth.Bytes32{0x01},
+				GasLimit:    30_000_000,
+			},
+		},
+		BlockTime:              2,
+		ChannelTimeoutBedrock:  300,
+		SeqWindowSize:          3600,
+		MaxSequencerDrift:      600,
+		BatchInboxAddress:      common.HexToAddress("0x2"),
+		DepositContractAddress: common.HexToAddress("0x3"),
+		L1ChainID:              big.NewInt(1),
+		L2ChainID:              big.NewInt(10),
+	}
+}
+
+// validConfig returns a minimal Config that passes Config.Check() on its own, so each test below
+// only needs to mutate the single field its validation branch cares about.
+func validConfig() *Config {
+	return &Config{
+		L1:                fakeEndpointSetup{},
+		L2:                fakeL2EndpointSetup{},
+		Driver:            driver.Config{},
+		Rollup:            validRollupConfig(),
+		Sync:              sync.Config{SyncMode: sync.CLSync},
+		ConfigPersistence: &DisabledConfigPersistence{},
+	}
+}
+
+func TestConfigCheck_SequencerPriority(t *testing.T) {
+	tests := []struct {
+		name              string
+		sequencerEnabled  bool
+		sequencerPriority bool
+		wantErr           string
+	}{
+		{
+			name:              "PriorityWithoutSequencerEnabledIsRejected",
+			sequencerEnabled:  false,
+			sequencerPriority: true,
+			wantErr:           "sequencer priority scheduling can only be enabled when the sequencer is enabled",
+		},
+		{
+			name:              "PriorityWithSequencerEnabledIsValid",
+			sequencerEnabled:  true,
+			sequencerPriority: true,
+		},
+		{
+			name:              "NoPriorityIsAlwaysValid",
+			sequencerEnabled:  false,
+			sequencerPriority: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.Driver.SequencerEnabled = test.sequencerEnabled
+			cfg.Driver.SequencerPriority = test.sequencerPriority
+			err := cfg.Check()
+			if test.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigCheck_EngineSync(t *testing.T) {
+	tests := []struct {
+		name             string
+		syncMode         sync.Mode
+		sequencerEnabled bool
+		wantErr          string
+	}{
+		{
+			name:             "EngineSyncOnSequencerIsRejected",
+			syncMode:         sync.EngineSync,
+			sequencerEnabled: true,
+			wantErr:          "engine-sync can only be enabled on verifier nodes, not sequencers",
+		},
+		{
+			name:     "EngineSyncOnVerifierIsValid",
+			syncMode: sync.EngineSync,
+		},
+		{
+			name:             "NonEngineSyncModeOnSequencerIsValid",
+			syncMode:         sync.CLSync,
+			sequencerEnabled: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.Sync.SyncMode = test.syncMode
+			cfg.Driver.SequencerEnabled = test.sequencerEnabled
+			err := cfg.Check()
+			if test.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigCheck_ReadOnly(t *testing.T) {
+	tests := []struct {
+		name             string
+		conductorEnabled bool
+		sequencerEnabled bool
+		p2pSigner        p2p.SignerSetup
+		wantErr          string
+	}{
+		{
+			name:             "ReadOnlyWithConductorIsRejected",
+			conductorEnabled: true,
+			wantErr:          "read-only mode cannot be combined with the conductor",
+		},
+		{
+			name:             "ReadOnlyWithSequencerIsRejected",
+			sequencerEnabled: true,
+			wantErr:          "read-only mode cannot be combined with the sequencer",
+		},
+		{
+			name:      "ReadOnlyWithP2PSignerIsRejected",
+			p2pSigner: fakeSignerSetup{},
+			wantErr:   "read-only mode cannot be combined with a configured P2P signer",
+		},
+		{
+			name: "ReadOnlyAloneIsValid",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.ReadOnly = true
+			cfg.ConductorEnabled = test.conductorEnabled
+			cfg.Driver.SequencerEnabled = test.sequencerEnabled
+			cfg.P2PSigner = test.p2pSigner
+			err := cfg.Check()
+			if test.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.wantErr)
+			}
+		})
+	}
+}
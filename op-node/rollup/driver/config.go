@@ -0,0 +1,37 @@
+package driver
+
+type Config struct {
+	// VerifierConfDepth is the distance to keep from the L1 head when reading L1 data for L2 derivation.
+	VerifierConfDepth uint64 `json:"verifier_conf_depth"`
+
+	// SequencerConfDepth is the distance to keep from the L1 head as origin when sequencing new L2 blocks.
+	// If this distance is too large, the sequencer may:
+	// - not adopt a L1 origin within the allowed time (rollup.Config.MaxSequencerDrift)
+	// - not adopt a L1 origin that can be included on L1 within the allowed range (rollup.Config.SeqWindowSize)
+	// and thus fail to produce a block with anything more than deposits.
+	SequencerConfDepth uint64 `json:"sequencer_conf_depth"`
+
+	// SequencerEnabled is true when the driver should sequence new blocks.
+	SequencerEnabled bool `json:"sequencer_enabled"`
+
+	// SequencerStopped is false when the driver should sequence new blocks.
+	SequencerStopped bool `json:"sequencer_stopped"`
+
+	// SequencerMaxSafeLag is the maximum number of L2 blocks for restricting the distance between L2 safe and unsafe.
+	// Disabled if 0.
+	SequencerMaxSafeLag uint64 `json:"sequencer_max_safe_lag"`
+
+	// SequencerPriority makes the driver's main loop check for, and run, a due sequencer
+	// build/seal step before draining derivation, gossip, or L1 head/finalization events, falling
+	// back to normal fair scheduling only when no sequencer step is currently due. Intended for
+	// high-throughput chains where derivation or peer traffic can otherwise starve the sequencer
+	// and cause missed slots.
+	SequencerPriority bool `json:"sequencer_priority"`
+
+	// SequencerCombinedEngine replaces the two-step GetPayload + ForkchoiceUpdated sequence at
+	// block seal with a single batched engine_getPayloadAndForkchoiceUpdatedVx call, when the
+	// connected L2 execution engine supports it. No capability probe is made at startup: the
+	// combined call is tried on the first seal, and if the engine reports it unsupported, that
+	// seal and every later one transparently use the split calls instead.
+	SequencerCombinedEngine bool `json:"sequencer_combined_engine"`
+}
@@ -0,0 +1,124 @@
+package driver
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Sequencer is the subset of the sequencing component that the driver's main loop needs in order
+// to check for, and run, a due block build/seal step.
+type Sequencer interface {
+	// NextAction reports when the next sequencer action (building or sealing a payload) is due.
+	// ok is false if the sequencer has no action currently scheduled.
+	NextAction() (t time.Time, ok bool)
+	// RunNextSequencerAction executes the next due sequencer action.
+	RunNextSequencerAction(ctx context.Context) error
+}
+
+// Derivation steps the L2 derivation pipeline forward.
+type Derivation interface {
+	Step(ctx context.Context) error
+}
+
+// EventLoop is the driver's main scheduling loop: it steps derivation in response to incoming
+// gossip and L1 head/finalization signals, and, when the sequencer is enabled, runs sequencer
+// actions as they come due.
+type EventLoop struct {
+	log log.Logger
+	cfg *Config
+
+	sequencer  Sequencer
+	derivation Derivation
+
+	gossipIn       <-chan struct{}
+	l1HeadSig      <-chan struct{}
+	l1FinalizedSig <-chan struct{}
+
+	driverCtx context.Context
+}
+
+func NewEventLoop(log log.Logger, cfg *Config, sequencer Sequencer, derivation Derivation,
+	gossipIn, l1HeadSig, l1FinalizedSig <-chan struct{}, driverCtx context.Context) *EventLoop {
+	return &EventLoop{
+		log:            log,
+		cfg:            cfg,
+		sequencer:      sequencer,
+		derivation:     derivation,
+		gossipIn:       gossipIn,
+		l1HeadSig:      l1HeadSig,
+		l1FinalizedSig: l1FinalizedSig,
+		driverCtx:      driverCtx,
+	}
+}
+
+// Start runs the loop until driverCtx is done.
+func (s *EventLoop) Start() {
+	for {
+		if s.driverCtx.Err() != nil {
+			return
+		}
+
+		// SequencerPriority: give a due sequencer build/seal step precedence over derivation,
+		// gossip, and L1 head/finalization work, so high-throughput chains don't miss slots
+		// because derivation or peer traffic starved the sequencer of its turn. Only fall
+		// through to fair scheduling below once no sequencer step is currently due.
+		if s.cfg.SequencerEnabled && !s.cfg.SequencerStopped && s.cfg.SequencerPriority {
+			if s.runDueSequencerAction() {
+				continue
+			}
+		}
+
+		timerC, stopTimer := s.sequencerTimer()
+		select {
+		case <-s.gossipIn:
+			s.step()
+		case <-s.l1HeadSig:
+			s.step()
+		case <-s.l1FinalizedSig:
+			s.step()
+		case <-timerC:
+			s.runDueSequencerAction()
+		case <-s.driverCtx.Done():
+			stopTimer()
+			return
+		}
+		stopTimer()
+	}
+}
+
+// sequencerTimer returns a channel that fires once the sequencer's next action comes due, so the
+// main select wakes up for it even if no gossip or L1 signal arrives in the meantime. It returns a
+// nil channel (which a select simply never selects) and a no-op stop func when the sequencer is
+// disabled, stopped, or has no action currently scheduled.
+func (s *EventLoop) sequencerTimer() (<-chan time.Time, func()) {
+	if !s.cfg.SequencerEnabled || s.cfg.SequencerStopped {
+		return nil, func() {}
+	}
+	t, ok := s.sequencer.NextAction()
+	if !ok {
+		return nil, func() {}
+	}
+	timer := time.NewTimer(time.Until(t))
+	return timer.C, func() { timer.Stop() }
+}
+
+func (s *EventLoop) step() {
+	if err := s.derivation.Step(s.driverCtx); err != nil {
+		s.log.Error("failed to step derivation pipeline", "err", err)
+	}
+}
+
+// runDueSequencerAction runs the next sequencer action if one is currently due, reporting
+// whether it did so.
+func (s *EventLoop) runDueSequencerAction() bool {
+	t, ok := s.sequencer.NextAction()
+	if !ok || time.Now().Before(t) {
+		return false
+	}
+	if err := s.sequencer.RunNextSequencerAction(s.driverCtx); err != nil {
+		s.log.Error("failed to run prioritized sequencer action", "err", err)
+	}
+	return true
+}
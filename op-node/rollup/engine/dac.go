@@ -0,0 +1,14 @@
+package engine
+
+import "context"
+
+// DACClient is the interface the engine layer uses to read and write L2 blob data to/from the
+// Data Availability Committee. It is satisfied by *dac.Client; it is declared here, rather than
+// imported from the dac package directly, so that this package does not need to depend on the HA
+// client's quorum/hedging implementation details.
+type DACClient interface {
+	// Put writes data to the DAC and returns its commitment.
+	Put(ctx context.Context, data []byte) ([]byte, error)
+	// Get fetches the data behind a commitment previously returned by Put.
+	Get(ctx context.Context, commitment []byte) ([]byte, error)
+}
@@ -0,0 +1,85 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// SyncEngine is the subset of the L2 execution engine API that engine-sync needs: forwarding an
+// unsafe payload via newPayload and driving the EL's forkchoice towards it.
+type SyncEngine interface {
+	NewPayload(ctx context.Context, payload *eth.ExecutionPayload, parentBeaconBlockRoot *common.Hash) (*eth.PayloadStatusV1, error)
+	ForkchoiceUpdate(ctx context.Context, state *eth.ForkchoiceState, attr *eth.PayloadAttributes) (*eth.ForkchoiceUpdatedResult, error)
+}
+
+// EngineSyncer drives sync.EngineSync: unsafe payloads received over gossip are forwarded
+// straight to the EL so it can snap-sync state on its own, bypassing derivation until the EL
+// reports the synced head as VALID.
+type EngineSyncer struct {
+	log    log.Logger
+	engine SyncEngine
+
+	// done is true once the EL has reported VALID for a forwarded head, at which point the
+	// driver should fall back to standard derivation-based safe-head advancement.
+	done bool
+}
+
+func NewEngineSyncer(log log.Logger, engine SyncEngine) *EngineSyncer {
+	return &EngineSyncer{log: log, engine: engine}
+}
+
+// MinEngineAPIVersion is the minimum engine API version a connected L2 execution engine must
+// advertise for engine-sync to be enabled: accepting a SYNCING status on newPayload while
+// snap-syncing was only made reliable from v2 onwards.
+const MinEngineAPIVersion = 2
+
+// CheckEngineSyncSupport verifies that a connected L2 execution engine advertising
+// advertisedVersion of the engine API supports engine-sync.
+func CheckEngineSyncSupport(advertisedVersion int) error {
+	if advertisedVersion < MinEngineAPIVersion {
+		return fmt.Errorf("engine-sync requires engine API v%d+, connected engine advertises v%d", MinEngineAPIVersion, advertisedVersion)
+	}
+	return nil
+}
+
+// Done reports whether engine-sync has completed and the driver should resume normal derivation.
+func (s *EngineSyncer) Done() bool {
+	return s.done
+}
+
+// ForwardUnsafePayload sends an unsafe payload gossiped to this node straight to the EL via
+// newPayload, accepting a SYNCING status, and then issues forkchoiceUpdated towards it so the EL
+// continues (or starts) snap-syncing state towards that head. Once the EL reports VALID for a
+// forwarded head, engine-sync is marked done and the driver should switch back to derivation.
+func (s *EngineSyncer) ForwardUnsafePayload(ctx context.Context, payload *eth.ExecutionPayload, parentBeaconBlockRoot *common.Hash) error {
+	status, err := s.engine.NewPayload(ctx, payload, parentBeaconBlockRoot)
+	if err != nil {
+		return fmt.Errorf("failed to forward unsafe payload %s to engine: %w", payload.BlockHash, err)
+	}
+	switch status.Status {
+	case eth.ExecutionValid, eth.ExecutionSyncing:
+		// Both are expected while the EL is snap-syncing towards this head.
+	default:
+		return fmt.Errorf("engine rejected forwarded unsafe payload %s: %s", payload.BlockHash, status.Status)
+	}
+
+	fc := eth.ForkchoiceState{
+		HeadBlockHash:      payload.BlockHash,
+		SafeBlockHash:      payload.BlockHash,
+		FinalizedBlockHash: payload.BlockHash,
+	}
+	res, err := s.engine.ForkchoiceUpdate(ctx, &fc, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update forkchoice towards forwarded unsafe payload %s: %w", payload.BlockHash, err)
+	}
+
+	if res.PayloadStatus.Status == eth.ExecutionValid {
+		s.log.Info("engine reports forwarded head as valid, engine-sync complete, resuming derivation", "block", payload.BlockHash)
+		s.done = true
+	}
+	return nil
+}
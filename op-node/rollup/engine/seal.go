@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ErrCombinedSealUnsupported is returned by SealEngine.GetPayloadAndForkchoiceUpdated when the
+// connected L2 execution engine does not advertise the combined
+// engine_getPayloadAndForkchoiceUpdatedVx method.
+var ErrCombinedSealUnsupported = errors.New("engine does not support combined getPayload+forkchoiceUpdated")
+
+// SealEngine is the subset of the L2 execution engine API that block sealing needs, either via
+// the combined single-round-trip call or the standard split GetPayload + ForkchoiceUpdated pair.
+type SealEngine interface {
+	// GetPayloadAndForkchoiceUpdated performs the combined engine_getPayloadAndForkchoiceUpdatedVx
+	// call, returning the sealed payload and applying fc in a single round trip. It returns
+	// ErrCombinedSealUnsupported if the connected EL does not advertise the combined method.
+	GetPayloadAndForkchoiceUpdated(ctx context.Context, id eth.PayloadID, fc eth.ForkchoiceState) (*eth.ExecutionPayloadEnvelope, error)
+	GetPayload(ctx context.Context, payloadInfo eth.PayloadInfo) (*eth.ExecutionPayloadEnvelope, error)
+	ForkchoiceUpdate(ctx context.Context, state *eth.ForkchoiceState, attr *eth.PayloadAttributes) (*eth.ForkchoiceUpdatedResult, error)
+}
+
+// SealMetrics are the Prometheus collectors the Sealer reports combined-vs-split path usage and
+// end-to-end seal time through.
+type SealMetrics struct {
+	SealsTotal *prometheus.CounterVec
+	SealTime   *prometheus.HistogramVec
+}
+
+func NewSealMetrics(ns string) *SealMetrics {
+	return &SealMetrics{
+		SealsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns, Subsystem: "sequencer", Name: "seals_total",
+			Help: "Number of block seals, labeled by whether the combined or split engine call path was used",
+		}, []string{"path"}),
+		SealTime: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns, Subsystem: "sequencer", Name: "seal_duration_seconds",
+			Help: "End-to-end block seal time, labeled by engine call path",
+		}, []string{"path"}),
+	}
+}
+
+// Sealer seals blocks against a SealEngine, preferring the combined
+// engine_getPayloadAndForkchoiceUpdatedVx call when Config.SequencerCombinedEngine is set. It
+// probes support for the combined call lazily and caches a negative result so that a single
+// unsupported EL doesn't pay the extra round trip on every subsequent seal.
+type Sealer struct {
+	log     log.Logger
+	engine  SealEngine
+	metrics *SealMetrics
+	combine bool
+
+	// combinedSupported tracks whether the combined call is still believed to be supported. It
+	// is only read/written from the driver's single-threaded sequencing loop.
+	combinedSupported bool
+}
+
+func NewSealer(log log.Logger, engine SealEngine, metrics *SealMetrics, combine bool) *Sealer {
+	return &Sealer{log: log, engine: engine, metrics: metrics, combine: combine, combinedSupported: combine}
+}
+
+// Seal produces and applies the sealed payload for id/fc, using the combined call when enabled
+// and supported, and transparently falling back to the split GetPayload + ForkchoiceUpdated calls
+// otherwise.
+func (s *Sealer) Seal(ctx context.Context, id eth.PayloadID, payloadInfo eth.PayloadInfo, fc eth.ForkchoiceState, attr *eth.PayloadAttributes) (*eth.ExecutionPayloadEnvelope, error) {
+	start := time.Now()
+	if s.combine && s.combinedSupported {
+		envelope, err := s.engine.GetPayloadAndForkchoiceUpdated(ctx, id, fc)
+		if err == nil {
+			s.observe("combined", start)
+			return envelope, nil
+		}
+		if !errors.Is(err, ErrCombinedSealUnsupported) {
+			return nil, err
+		}
+		s.log.Warn("L2 engine does not support combined getPayload+forkchoiceUpdated, falling back to split calls")
+		s.combinedSupported = false
+	}
+
+	envelope, err := s.engine.GetPayload(ctx, payloadInfo)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.engine.ForkchoiceUpdate(ctx, &fc, attr); err != nil {
+		return nil, err
+	}
+	s.observe("split", start)
+	return envelope, nil
+}
+
+func (s *Sealer) observe(path string, start time.Time) {
+	if s.metrics == nil {
+		return
+	}
+	s.metrics.SealsTotal.WithLabelValues(path).Inc()
+	s.metrics.SealTime.WithLabelValues(path).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,87 @@
+package sync
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Mode int
+
+// There are three kinds of sync mode that the op-node does:
+//  1. In consensus-layer (CL) sync, the op-node fully drives the execution client and imports unsafe blocks &
+//     fetches unsafe blocks that it has missed.
+//  2. In execution-layer (EL) sync, the op-node tells the execution client to sync towards the tip of the chain.
+//     It will consolidate the chain as usual. This allows execution clients to snap sync if they are capable of it.
+//  3. In engine-sync, the op-node forwards unsafe payloads it receives over gossip straight to the EL via
+//     newPayload (accepting a SyncStatus of SYNCING) and drives forkchoiceUpdated towards the unsafe head, so the
+//     EL can snap-sync state on its own without the op-node deriving the chain from L1 first. Once the EL reports
+//     VALID for the synced head, the driver falls back to normal derivation-based safe-head advancement. Unlike
+//     ELSync, this is driven by the op-node's own unsafe-payload gossip rather than the EL's independent p2p sync,
+//     and is restricted to verifier nodes: a sequencer cannot engine-sync off of its own unsafe payloads.
+const (
+	CLSync Mode = iota
+	ELSync
+	EngineSync
+)
+
+const (
+	CLSyncString     string = "consensus-layer"
+	ELSyncString     string = "execution-layer"
+	EngineSyncString string = "engine"
+)
+
+var Modes = []Mode{CLSync, ELSync, EngineSync}
+var ModeStrings = []string{CLSyncString, ELSyncString, EngineSyncString}
+
+func StringToMode(s string) (Mode, error) {
+	switch strings.ToLower(s) {
+	case CLSyncString:
+		return CLSync, nil
+	case ELSyncString:
+		return ELSync, nil
+	case EngineSyncString:
+		return EngineSync, nil
+	default:
+		return 0, fmt.Errorf("unknown sync mode: %s", s)
+	}
+}
+
+func (m Mode) String() string {
+	switch m {
+	case CLSync:
+		return CLSyncString
+	case ELSync:
+		return ELSyncString
+	case EngineSync:
+		return EngineSyncString
+	default:
+		return "unknown"
+	}
+}
+
+func (m *Mode) Set(value string) error {
+	v, err := StringToMode(value)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+func (m *Mode) Clone() any {
+	cpy := *m
+	return &cpy
+}
+
+type Config struct {
+	// SyncMode is defined above.
+	SyncMode Mode `json:"syncmode"`
+	// SkipSyncStartCheck skip the sanity check of consistency of L1 origins of the unsafe L2 blocks when determining the sync-starting point.
+	// This defers the L1-origin verification, and is recommended to use in when utilizing --syncmode=execution-layer on op-node and --syncmode=snap on op-geth
+	// Warning: This will be removed when we implement proper checkpoints.
+	// Note: We probably need to detect the condition that snap sync has not complete when we do a restart prior to running sync-start if we are doing
+	// snap sync with a genesis finalization data.
+	SkipSyncStartCheck bool `json:"skip_sync_start_check"`
+
+	SupportsPostFinalizationELSync bool `json:"supports_post_finalization_elsync"`
+}